@@ -0,0 +1,22 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAddPlaylistImageBlurHash, downAddPlaylistImageBlurHash)
+}
+
+func upAddPlaylistImageBlurHash(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `alter table playlist add column image_blur_hash varchar default '' not null;`)
+	return err
+}
+
+func downAddPlaylistImageBlurHash(_ context.Context, tx *sql.Tx) error {
+	_, err := tx.Exec(`alter table playlist drop column image_blur_hash;`)
+	return err
+}
@@ -0,0 +1,97 @@
+package migrations
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/navidrome/navidrome/conf"
+	"github.com/navidrome/navidrome/log"
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upBackfillPlaylistImagePath, downBackfillPlaylistImagePath)
+}
+
+const legacyPlaylistImageDir = "playlist-images"
+
+var playlistImageHashRE = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// upBackfillPlaylistImagePath migrates playlist.image_path from the old scheme, an absolute
+// filesystem path set before covers were content-addressed, to the new one: a sha256 hash of
+// the image's bytes, stored under DataFolder/playlist-images. Rows already in the new format
+// (a bare hash) are left untouched. If a playlist's old image file can no longer be found on
+// disk, there is nothing left to migrate, so its image_path is simply cleared.
+func upBackfillPlaylistImagePath(ctx context.Context, tx *sql.Tx) error {
+	rows, err := tx.QueryContext(ctx, `select id, image_path from playlist where image_path <> ''`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	type legacyImage struct{ id, path string }
+	var legacy []legacyImage
+	for rows.Next() {
+		var id, path string
+		if err := rows.Scan(&id, &path); err != nil {
+			return err
+		}
+		if playlistImageHashRE.MatchString(path) {
+			continue
+		}
+		legacy = append(legacy, legacyImage{id, path})
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	imageDir := filepath.Join(conf.Server.DataFolder, legacyPlaylistImageDir)
+	for _, img := range legacy {
+		hash, err := rehashLegacyPlaylistImage(imageDir, img.path)
+		if err != nil {
+			log.Warn(ctx, "Could not migrate legacy playlist cover image, clearing it", "playlist", img.id, "path", img.path, err)
+			hash = ""
+		}
+		if _, err := tx.ExecContext(ctx, `update playlist set image_path = ? where id = ?`, hash, img.id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rehashLegacyPlaylistImage reads the image file at the old absolute path and writes it to the
+// content-addressed location under imageDir, unless a file with that hash is already there, and
+// returns its sha256 hash.
+func rehashLegacyPlaylistImage(imageDir, oldPath string) (string, error) {
+	data, err := os.ReadFile(oldPath)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+
+	destPath := filepath.Join(imageDir, hash+".jpg")
+	if _, err := os.Stat(destPath); errors.Is(err, os.ErrNotExist) {
+		if err := os.MkdirAll(imageDir, 0o755); err != nil {
+			return "", err
+		}
+		if err := os.WriteFile(destPath, data, 0o644); err != nil {
+			return "", err
+		}
+	} else if err != nil {
+		return "", err
+	}
+	return hash, nil
+}
+
+func downBackfillPlaylistImagePath(_ context.Context, _ *sql.Tx) error {
+	// The old absolute paths aren't recoverable once a file has been rehashed and migrated in
+	// place, so there is nothing meaningful to revert.
+	return nil
+}
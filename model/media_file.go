@@ -0,0 +1,10 @@
+package model
+
+// MediaFile represents a single track.
+//
+// This only lists the field that server/nativeapi/playlist_image.go depends on (grouping
+// playlist tracks by album to suggest a cover); the full MediaFile model lives alongside the
+// rest of the persistence layer outside this source tree.
+type MediaFile struct {
+	AlbumID string `structs:"album_id" json:"albumId"`
+}
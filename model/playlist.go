@@ -0,0 +1,40 @@
+package model
+
+import "time"
+
+// Playlist is a named, ordered collection of tracks owned by a user.
+//
+// This only lists the fields that server/nativeapi/playlist_image.go depends on, plus
+// CreatedAt; the rest of
+// the Playlist model (smart playlist rules, evaluation, full track listing, etc.) lives
+// alongside the rest of the persistence layer outside this source tree.
+type Playlist struct {
+	ID      string `structs:"id" json:"id"`
+	OwnerID string `structs:"owner_id" json:"ownerId"`
+	Public  bool   `structs:"public" json:"public"`
+
+	// ImagePath holds the sha256 hash of the playlist's custom cover image (content-addressed
+	// under DataFolder/playlist-images), or "" if no custom cover has been set.
+	ImagePath string `structs:"image_path" json:"-"`
+
+	// ImageBlurHash is a tiny BlurHash placeholder for the image at ImagePath, so clients can
+	// render an instant approximation while the full-size cover is still loading. It is
+	// recomputed whenever a new cover image is stored; covers carried over from the old
+	// path-based storage by the backfill migration have none.
+	ImageBlurHash string `structs:"image_blur_hash" json:"blurHash,omitempty"`
+
+	Tracks PlaylistTracks `structs:"-" json:"tracks,omitempty"`
+
+	CreatedAt time.Time `structs:"created_at" json:"createdAt"`
+	UpdatedAt time.Time `structs:"updated_at" json:"updatedAt"`
+}
+
+// PlaylistTrack pairs a playlist entry with the underlying media file it points at.
+type PlaylistTrack struct {
+	MediaFile
+	ID         string `structs:"id" json:"id"`
+	PlaylistID string `structs:"playlist_id" json:"playlistId"`
+}
+
+// PlaylistTracks is an ordered list of a playlist's tracks.
+type PlaylistTracks []PlaylistTrack
@@ -1,18 +1,33 @@
 package nativeapi
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"image"
+	"image/color"
 	// Register image format decoders
 	_ "image/gif"
-	_ "image/jpeg"
+	"image/jpeg"
 	_ "image/png"
+	"io"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/Masterminds/squirrel"
+	"github.com/buckket/go-blurhash"
 	"github.com/disintegration/imaging"
 	"github.com/go-chi/chi/v5"
 	"github.com/navidrome/navidrome/conf"
@@ -27,12 +42,73 @@ const (
 	maxUploadSize     = 5 << 20 // 5 MB
 	maxImageDimension = 1200
 	playlistImageDir  = "playlist-images"
+
+	// blurHashComponentsX/Y set the DCT grid size used to encode playlist image BlurHashes.
+	// 4x3 is a good balance between placeholder detail and the ~30 byte string size.
+	blurHashComponentsX  = 4
+	blurHashComponentsY  = 3
+	blurHashMaxDimension = 32
 )
 
-// playlistImagePath returns the filesystem path where a playlist's custom image should be stored.
-// It validates that the resulting path is safely within the data folder to prevent path traversal.
-func playlistImagePath(playlistID string) (string, bool) {
-	p := filepath.Join(conf.Server.DataFolder, playlistImageDir, playlistID)
+// playlistImageFetchClient downloads playlist cover images referenced by URL. Every connection
+// it opens — including ones opened to follow a redirect — is validated against
+// isBlockedFetchAddr right before the TCP connect, by dialing the resolved IP directly instead
+// of letting net/http re-resolve the hostname at connect time. This closes two gaps a one-shot
+// host check misses: a redirect response pointing at a private address, and DNS rebinding
+// between validation and the actual connection.
+var playlistImageFetchClient = newPlaylistImageFetchClient()
+
+func newPlaylistImageFetchClient() *http.Client {
+	dialer := &net.Dialer{Timeout: 5 * time.Second}
+	return &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				host, port, err := net.SplitHostPort(addr)
+				if err != nil {
+					return nil, err
+				}
+				ips, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+				if err != nil {
+					return nil, fmt.Errorf("could not resolve host: %w", err)
+				}
+				var lastErr error
+				for _, ip := range ips {
+					if isBlockedFetchAddr(ip.IP) {
+						lastErr = fmt.Errorf("address %s is not allowed", ip.IP)
+						continue
+					}
+					// Dial the already-validated IP literal, not the hostname, so the
+					// connection can't resolve to a different (unvalidated) address.
+					conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(ip.IP.String(), port))
+					if err == nil {
+						return conn, nil
+					}
+					lastErr = err
+				}
+				if lastErr == nil {
+					lastErr = fmt.Errorf("could not resolve host %q", host)
+				}
+				return nil, lastErr
+			},
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return errors.New("too many redirects")
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return fmt.Errorf("unsupported redirect scheme %q", req.URL.Scheme)
+			}
+			return nil
+		},
+	}
+}
+
+// playlistImagePath returns the filesystem path of the content-addressed image file for the
+// given sha256 hash (as stored in the playlist's image_path column). It validates that the
+// resulting path is safely within the data folder to prevent path traversal.
+func playlistImagePath(hash string) (string, bool) {
+	p := filepath.Join(conf.Server.DataFolder, playlistImageDir, hash+".jpg")
 	p = filepath.Clean(p)
 	base := filepath.Clean(filepath.Join(conf.Server.DataFolder, playlistImageDir))
 	if !strings.HasPrefix(p, base+string(filepath.Separator)) {
@@ -41,30 +117,178 @@ func playlistImagePath(playlistID string) (string, bool) {
 	return p, true
 }
 
+// getPlaylistForImageUpdate fetches the playlist and verifies the caller is allowed to
+// change its cover image, returning the HTTP status and message to use if it is not.
+func getPlaylistForImageUpdate(ctx context.Context, ds model.DataStore, playlistID string) (*model.Playlist, int, error) {
+	pls, err := ds.Playlist(ctx).Get(playlistID)
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, http.StatusNotFound, errors.New("playlist not found")
+	}
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return nil, http.StatusUnauthorized, errors.New("unauthorized")
+	}
+	if pls.OwnerID != user.ID && !user.IsAdmin {
+		return nil, http.StatusForbidden, errors.New("you do not have permission to modify this playlist")
+	}
+	return pls, 0, nil
+}
+
+// getPlaylistForImageView fetches the playlist and verifies the caller is allowed to see its
+// cover image: its owner, an admin, or anyone if the playlist is public. Returns the HTTP status
+// and message to use if not.
+func getPlaylistForImageView(ctx context.Context, ds model.DataStore, playlistID string) (*model.Playlist, int, error) {
+	pls, err := ds.Playlist(ctx).Get(playlistID)
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, http.StatusNotFound, errors.New("playlist not found")
+	}
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if pls.Public {
+		return pls, 0, nil
+	}
+
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return nil, http.StatusUnauthorized, errors.New("unauthorized")
+	}
+	if pls.OwnerID != user.ID && !user.IsAdmin {
+		return nil, http.StatusForbidden, errors.New("you do not have permission to view this playlist")
+	}
+	return pls, 0, nil
+}
+
+// writeImageResponse writes the standard {id, blurHash} JSON body shared by every handler that
+// sets or clears a playlist's cover image. blurHash may be "" (e.g. after a delete), in which
+// case it's omitted from the response.
+func writeImageResponse(ctx context.Context, w http.ResponseWriter, playlistID, blurHash string) {
+	resp, err := json.Marshal(struct {
+		ID       string `json:"id"`
+		BlurHash string `json:"blurHash,omitempty"`
+	}{ID: playlistID, BlurHash: blurHash})
+	if err != nil {
+		log.Error(ctx, "Error marshalling response", err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(resp)
+}
+
+// blurHashForImage encodes a tiny BlurHash placeholder for img, so clients can render an
+// instant approximation while the full cover art is still loading.
+func blurHashForImage(img image.Image) (string, error) {
+	bounds := img.Bounds()
+	if bounds.Dx() > blurHashMaxDimension || bounds.Dy() > blurHashMaxDimension {
+		img = imaging.Fit(img, blurHashMaxDimension, blurHashMaxDimension, imaging.Lanczos)
+	}
+	return blurhash.Encode(blurHashComponentsX, blurHashComponentsY, img)
+}
+
+// writeContentAddressedImage JPEG-encodes img, streaming the bytes through a sha256 hasher as
+// they're produced, and writes the result to disk under its hash if no file with that hash
+// already exists (i.e. nobody has uploaded these exact bytes before). It returns the hash.
+func writeContentAddressedImage(img image.Image) (string, error) {
+	var buf bytes.Buffer
+	hasher := sha256.New()
+	if err := jpeg.Encode(io.MultiWriter(hasher, &buf), img, &jpeg.Options{Quality: conf.Server.CoverJpegQuality}); err != nil {
+		return "", fmt.Errorf("encoding playlist image: %w", err)
+	}
+	hash := hex.EncodeToString(hasher.Sum(nil))
+
+	destPath, safe := playlistImagePath(hash)
+	if !safe {
+		return "", fmt.Errorf("invalid playlist image hash %q", hash)
+	}
+	if err := os.MkdirAll(filepath.Dir(destPath), 0o755); err != nil {
+		return "", fmt.Errorf("creating playlist image directory: %w", err)
+	}
+	if _, err := os.Stat(destPath); errors.Is(err, os.ErrNotExist) {
+		if err := os.WriteFile(destPath, buf.Bytes(), 0o644); err != nil {
+			return "", fmt.Errorf("saving playlist image: %w", err)
+		}
+	} else if err != nil {
+		return "", fmt.Errorf("checking existing playlist image: %w", err)
+	}
+	return hash, nil
+}
+
+// removePlaylistImageIfUnreferenced deletes the on-disk file for hash unless another playlist
+// still points at it, so covers shared across playlists (via deduplication) aren't deleted out
+// from under each other.
+func removePlaylistImageIfUnreferenced(ctx context.Context, ds model.DataStore, hash string) {
+	if hash == "" {
+		return
+	}
+	count, err := ds.Playlist(ctx).CountAll(model.QueryOptions{Filters: squirrel.Eq{"image_path": hash}})
+	if err != nil {
+		log.Error(ctx, "Error checking playlist image references", "hash", hash, err)
+		return
+	}
+	if count > 0 {
+		return
+	}
+	path, safe := playlistImagePath(hash)
+	if !safe {
+		return
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		log.Error(ctx, "Error removing unreferenced playlist image", "path", path, err)
+	}
+}
+
+// processAndStorePlaylistImage resizes img if needed, stores it content-addressed by its
+// sha256 hash and updates the playlist record. It is shared by every handler that sets a new
+// image, regardless of how the image bytes were obtained.
+func processAndStorePlaylistImage(ctx context.Context, ds model.DataStore, pls *model.Playlist, img image.Image) error {
+	bounds := img.Bounds()
+	if bounds.Dx() > maxImageDimension || bounds.Dy() > maxImageDimension {
+		img = imaging.Fit(img, maxImageDimension, maxImageDimension, imaging.Lanczos)
+	}
+
+	hash, err := writeContentAddressedImage(img)
+	if err != nil {
+		return err
+	}
+
+	bh, err := blurHashForImage(img)
+	if err != nil {
+		// A bad BlurHash is not worth failing the whole upload over; the UI just won't
+		// have a placeholder to show while the real cover loads.
+		log.Warn(ctx, "Error computing playlist image BlurHash", "playlistId", pls.ID, err)
+		bh = ""
+	}
+
+	previousHash := pls.ImagePath
+	pls.ImagePath = hash
+	pls.ImageBlurHash = bh
+	if err := ds.Playlist(ctx).Put(pls); err != nil {
+		return fmt.Errorf("updating playlist with image path: %w", err)
+	}
+	if previousHash != "" && previousHash != hash {
+		removePlaylistImageIfUnreferenced(ctx, ds, previousHash)
+	}
+	// Any cached resized variants were rendered from the old original and are now stale.
+	removeResizedPlaylistImageCache(ctx, pls.ID)
+	return nil
+}
+
 func uploadPlaylistImage(ds model.DataStore) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		playlistID := chi.URLParam(r, "playlistId")
 
-		// Verify the playlist exists and the user has permission to modify it
-		pls, err := ds.Playlist(ctx).Get(playlistID)
-		if errors.Is(err, model.ErrNotFound) {
-			http.Error(w, "playlist not found", http.StatusNotFound)
-			return
-		}
+		pls, status, err := getPlaylistForImageUpdate(ctx, ds, playlistID)
 		if err != nil {
-			log.Error(ctx, "Error fetching playlist", "playlistId", playlistID, err)
-			http.Error(w, "internal server error", http.StatusInternalServerError)
-			return
-		}
-
-		user, ok := request.UserFrom(ctx)
-		if !ok {
-			http.Error(w, "unauthorized", http.StatusUnauthorized)
-			return
-		}
-		if pls.OwnerID != user.ID && !user.IsAdmin {
-			http.Error(w, "you do not have permission to modify this playlist", http.StatusForbidden)
+			if status == http.StatusInternalServerError {
+				log.Error(ctx, "Error fetching playlist", "playlistId", playlistID, err)
+			}
+			http.Error(w, err.Error(), status)
 			return
 		}
 
@@ -95,55 +319,145 @@ func uploadPlaylistImage(ds model.DataStore) http.HandlerFunc {
 		}
 		log.Debug(ctx, "Received playlist image upload", "playlistId", playlistID, "filename", header.Filename, "format", format, "size", header.Size)
 
-		// Resize if necessary (cap at maxImageDimension x maxImageDimension, preserving aspect ratio)
-		bounds := img.Bounds()
-		if bounds.Dx() > maxImageDimension || bounds.Dy() > maxImageDimension {
-			img = imaging.Fit(img, maxImageDimension, maxImageDimension, imaging.Lanczos)
+		if err := processAndStorePlaylistImage(ctx, ds, pls, img); err != nil {
+			log.Error(ctx, "Error storing uploaded playlist image", "playlistId", playlistID, err)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			return
 		}
 
-		// Ensure the storage directory path is safe
-		dir, safe := playlistImagePath(playlistID)
-		if !safe {
-			log.Error(ctx, "Invalid playlist image path", "playlistId", playlistID)
-			http.Error(w, "invalid playlist id", http.StatusBadRequest)
+		log.Info(ctx, "Custom playlist image uploaded", "playlistId", playlistID, "path", pls.ImagePath)
+
+		writeImageResponse(ctx, w, playlistID, pls.ImageBlurHash)
+	}
+}
+
+// fetchImageRequest is the JSON body accepted by fetchPlaylistImageFromURL.
+type fetchImageRequest struct {
+	URL string `json:"url"`
+}
+
+// validateFetchURL parses rawURL and rejects obviously disallowed targets up front: only
+// http(s) schemes are accepted, and every address the host currently resolves to must be a
+// public address. This is a fast-fail check for a better error message; the authoritative
+// enforcement happens per-connection in playlistImageFetchClient's DialContext, which
+// re-resolves and re-validates at dial time (covering redirects and DNS rebinding, which a
+// one-shot check like this one can't).
+func validateFetchURL(ctx context.Context, rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported url scheme %q", u.Scheme)
+	}
+	host := u.Hostname()
+	if host == "" {
+		return nil, errors.New("url is missing a host")
+	}
+
+	ips, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve host: %w", err)
+	}
+	for _, ip := range ips {
+		if isBlockedFetchAddr(ip.IP) {
+			return nil, errors.New("url resolves to a disallowed address")
+		}
+	}
+	return u, nil
+}
+
+// isBlockedFetchAddr reports whether ip must not be reached by server-side fetches.
+func isBlockedFetchAddr(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsPrivate()
+}
+
+// fetchPlaylistImageFromURL downloads an image from a user-supplied URL and stores it as the
+// playlist's cover, so clients can set a cover without proxying the image bytes through
+// themselves first.
+func fetchPlaylistImageFromURL(ds model.DataStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		playlistID := chi.URLParam(r, "playlistId")
+
+		pls, status, err := getPlaylistForImageUpdate(ctx, ds, playlistID)
+		if err != nil {
+			if status == http.StatusInternalServerError {
+				log.Error(ctx, "Error fetching playlist", "playlistId", playlistID, err)
+			}
+			http.Error(w, err.Error(), status)
 			return
 		}
-		if err := os.MkdirAll(dir, 0o755); err != nil {
-			log.Error(ctx, "Error creating playlist image directory", "dir", dir, err)
-			http.Error(w, "internal server error", http.StatusInternalServerError)
+
+		var req fetchImageRequest
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 4<<10)).Decode(&req); err != nil || req.URL == "" {
+			http.Error(w, `missing or invalid "url" field`, http.StatusBadRequest)
 			return
 		}
 
-		// Save as JPEG for consistent format and smaller file size
-		destPath := filepath.Join(dir, "cover.jpg")
-		if err := imaging.Save(img, destPath, imaging.JPEGQuality(conf.Server.CoverJpegQuality)); err != nil {
-			log.Error(ctx, "Error saving playlist image", "path", destPath, err)
-			http.Error(w, "internal server error", http.StatusInternalServerError)
+		imgURL, err := validateFetchURL(ctx, req.URL)
+		if err != nil {
+			log.Warn(ctx, "Rejected playlist image fetch url", "playlistId", playlistID, "url", req.URL, err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
 
-		// Update the playlist record with the image path. Put() bumps UpdatedAt automatically.
-		pls.ImagePath = destPath
-		if err := ds.Playlist(ctx).Put(pls); err != nil {
-			log.Error(ctx, "Error updating playlist with image path", "playlistId", playlistID, err)
-			// Try to clean up the saved file
-			_ = os.Remove(destPath)
+		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, imgURL.String(), nil)
+		if err != nil {
+			log.Error(ctx, "Error building playlist image fetch request", err)
 			http.Error(w, "internal server error", http.StatusInternalServerError)
 			return
 		}
 
-		log.Info(ctx, "Custom playlist image uploaded", "playlistId", playlistID, "path", destPath)
+		resp, err := playlistImageFetchClient.Do(httpReq)
+		if err != nil {
+			log.Warn(ctx, "Error fetching remote playlist image", "url", req.URL, err)
+			http.Error(w, "could not fetch image from url", http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			log.Warn(ctx, "Remote server returned an error for playlist image fetch", "url", req.URL, "status", resp.StatusCode)
+			http.Error(w, "could not fetch image from url", http.StatusBadGateway)
+			return
+		}
+		if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "image/") {
+			http.Error(w, "url did not return an image", http.StatusBadRequest)
+			return
+		}
 
-		resp, err := json.Marshal(struct {
-			ID string `json:"id"`
-		}{ID: playlistID})
+		// Don't trust Content-Length: enforce the cap while reading the body instead.
+		data, err := io.ReadAll(io.LimitReader(resp.Body, maxUploadSize+1))
 		if err != nil {
-			log.Error(ctx, "Error marshalling response", err)
+			log.Warn(ctx, "Error reading remote playlist image", "url", req.URL, err)
+			http.Error(w, "could not read image from url", http.StatusBadGateway)
+			return
+		}
+		if len(data) > maxUploadSize {
+			http.Error(w, "image too large (max 5MB)", http.StatusBadRequest)
+			return
+		}
+
+		img, format, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			log.Warn(ctx, "Remote file is not a valid image", "url", req.URL, err)
+			http.Error(w, "invalid image file", http.StatusBadRequest)
+			return
+		}
+		log.Debug(ctx, "Fetched playlist image from url", "playlistId", playlistID, "url", req.URL, "format", format, "size", len(data))
+
+		if err := processAndStorePlaylistImage(ctx, ds, pls, img); err != nil {
+			log.Error(ctx, "Error storing fetched playlist image", "playlistId", playlistID, err)
 			http.Error(w, "internal server error", http.StatusInternalServerError)
 			return
 		}
-		w.Header().Set("Content-Type", "application/json")
-		_, _ = w.Write(resp)
+
+		log.Info(ctx, "Custom playlist image fetched from url", "playlistId", playlistID, "url", req.URL)
+
+		writeImageResponse(ctx, w, playlistID, pls.ImageBlurHash)
 	}
 }
 
@@ -152,55 +466,404 @@ func deletePlaylistImage(ds model.DataStore) http.HandlerFunc {
 		ctx := r.Context()
 		playlistID := chi.URLParam(r, "playlistId")
 
-		// Verify the playlist exists and the user has permission
-		pls, err := ds.Playlist(ctx).Get(playlistID)
-		if errors.Is(err, model.ErrNotFound) {
-			http.Error(w, "playlist not found", http.StatusNotFound)
+		pls, status, err := getPlaylistForImageUpdate(ctx, ds, playlistID)
+		if err != nil {
+			if status == http.StatusInternalServerError {
+				log.Error(ctx, "Error fetching playlist", "playlistId", playlistID, err)
+			}
+			http.Error(w, err.Error(), status)
 			return
 		}
-		if err != nil {
-			log.Error(ctx, "Error fetching playlist", "playlistId", playlistID, err)
-			http.Error(w, "internal server error", http.StatusInternalServerError)
+
+		if pls.ImagePath == "" {
+			http.Error(w, "playlist has no custom image", http.StatusNotFound)
 			return
 		}
 
-		user, ok := request.UserFrom(ctx)
-		if !ok {
-			http.Error(w, "unauthorized", http.StatusUnauthorized)
+		hash := pls.ImagePath
+
+		// Clear the image path and BlurHash from the playlist record. Put() bumps UpdatedAt automatically.
+		pls.ImagePath = ""
+		pls.ImageBlurHash = ""
+		if err := ds.Playlist(ctx).Put(pls); err != nil {
+			log.Error(ctx, "Error clearing playlist image path", "playlistId", playlistID, err)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
 			return
 		}
-		if pls.OwnerID != user.ID && !user.IsAdmin {
-			http.Error(w, "you do not have permission to modify this playlist", http.StatusForbidden)
+
+		// Only remove the underlying file once no other playlist references the same image.
+		removePlaylistImageIfUnreferenced(ctx, ds, hash)
+		removeResizedPlaylistImageCache(ctx, playlistID)
+
+		log.Info(ctx, "Custom playlist image removed", "playlistId", playlistID)
+
+		writeImageResponse(ctx, w, playlistID, "")
+	}
+}
+
+// allowedPlaylistImageSizes bounds the set of resized variants we'll ever generate, so a
+// client can't force us to cache an unbounded number of cover sizes.
+var allowedPlaylistImageSizes = []int{64, 128, 256, 512, 1200}
+
+const defaultPlaylistImageSize = 256
+
+// clampPlaylistImageSize rounds requested up to the smallest allowed size that fits it,
+// falling back to the largest allowed size if requested exceeds all of them.
+func clampPlaylistImageSize(requested int) int {
+	for _, s := range allowedPlaylistImageSizes {
+		if requested <= s {
+			return s
+		}
+	}
+	return allowedPlaylistImageSizes[len(allowedPlaylistImageSizes)-1]
+}
+
+// resizedPlaylistImageCacheDir returns the directory holding every cached resized variant of a
+// playlist's cover, validating that it stays within the data folder.
+func resizedPlaylistImageCacheDir(playlistID string) (string, bool) {
+	p := filepath.Clean(filepath.Join(conf.Server.DataFolder, playlistImageDir, playlistID))
+	base := filepath.Clean(filepath.Join(conf.Server.DataFolder, playlistImageDir))
+	if !strings.HasPrefix(p, base+string(filepath.Separator)) {
+		return "", false
+	}
+	return p, true
+}
+
+// resizedPlaylistImageCachePath returns the filesystem path of the cached resized variant of
+// a playlist's cover at the given size, validating that it stays within the data folder.
+func resizedPlaylistImageCachePath(playlistID string, size int) (string, bool) {
+	dir, safe := resizedPlaylistImageCacheDir(playlistID)
+	if !safe {
+		return "", false
+	}
+	return filepath.Join(dir, fmt.Sprintf("cover_%d.jpg", size)), true
+}
+
+// removeResizedPlaylistImageCache deletes every cached resized variant of a playlist's cover,
+// so a stale size rendered from the old original isn't served once the cover changes or is
+// removed. It's best-effort: a failure here just means some disk space goes unreclaimed, not a
+// user-visible error, since the cache is regenerated lazily from the current original anyway.
+func removeResizedPlaylistImageCache(ctx context.Context, playlistID string) {
+	dir, safe := resizedPlaylistImageCacheDir(playlistID)
+	if !safe {
+		return
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		log.Error(ctx, "Error removing cached resized playlist images", "playlistId", playlistID, err)
+	}
+}
+
+// renderResizedPlaylistImage reads the stored original at originalHash, resizes it to a size x
+// size square and writes the result to cachePath.
+func renderResizedPlaylistImage(originalHash string, cachePath string, size int) error {
+	srcPath, safe := playlistImagePath(originalHash)
+	if !safe {
+		return fmt.Errorf("invalid playlist image hash %q", originalHash)
+	}
+	img, err := imaging.Open(srcPath)
+	if err != nil {
+		return fmt.Errorf("opening stored playlist image: %w", err)
+	}
+	resized := imaging.Fill(img, size, size, imaging.Center, imaging.Lanczos)
+
+	if err := os.MkdirAll(filepath.Dir(cachePath), 0o755); err != nil {
+		return fmt.Errorf("creating playlist image cache directory: %w", err)
+	}
+	if err := imaging.Save(resized, cachePath, imaging.JPEGQuality(conf.Server.CoverJpegQuality)); err != nil {
+		return fmt.Errorf("saving resized playlist image: %w", err)
+	}
+	return nil
+}
+
+// isCacheFresh reports whether the file at path exists and was written at or after modTime,
+// i.e. it was generated from the image currently stored on the playlist.
+func isCacheFresh(path string, modTime time.Time) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	return !info.ModTime().Before(modTime)
+}
+
+// serveFallbackPlaylistArtwork is used for playlists without a custom cover. It hands off to
+// the standard cover art endpoint, which already renders the mosaic/first-track-art image
+// used throughout Navidrome for playlists, so clients can hit a single URL either way.
+func serveFallbackPlaylistArtwork(w http.ResponseWriter, r *http.Request, pls *model.Playlist, size int) {
+	target := fmt.Sprintf("/api/cover/%s?size=%d", pls.CoverArtID().String(), size)
+	http.Redirect(w, r, target, http.StatusFound)
+}
+
+// parseImageSize parses the "size" query parameter, falling back to defaultPlaylistImageSize
+// if it is missing or not a positive integer.
+func parseImageSize(raw string) int {
+	if raw == "" {
+		return defaultPlaylistImageSize
+	}
+	size, err := strconv.Atoi(raw)
+	if err != nil || size <= 0 {
+		return defaultPlaylistImageSize
+	}
+	return size
+}
+
+// getPlaylistImage serves a playlist's cover art, resized to the requested (clamped) square
+// size. Resized variants are cached on disk so repeated requests (e.g. from an album grid)
+// don't re-encode the image every time.
+func getPlaylistImage(ds model.DataStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		playlistID := chi.URLParam(r, "playlistId")
+
+		pls, status, err := getPlaylistForImageView(ctx, ds, playlistID)
+		if err != nil {
+			if status == http.StatusInternalServerError {
+				log.Error(ctx, "Error fetching playlist", "playlistId", playlistID, err)
+			}
+			http.Error(w, err.Error(), status)
 			return
 		}
 
+		size := clampPlaylistImageSize(parseImageSize(r.URL.Query().Get("size")))
+
 		if pls.ImagePath == "" {
-			http.Error(w, "playlist has no custom image", http.StatusNotFound)
+			serveFallbackPlaylistArtwork(w, r, pls, size)
 			return
 		}
 
-		// Remove the image file and directory, validating the path first
-		dir, safe := playlistImagePath(playlistID)
-		if safe {
-			if err := os.RemoveAll(dir); err != nil {
-				log.Error(ctx, "Error removing playlist image directory", "dir", dir, err)
-				// Continue anyway to clear the DB reference
+		cachePath, safe := resizedPlaylistImageCachePath(pls.ID, size)
+		if !safe {
+			log.Error(ctx, "Invalid playlist image cache path", "playlistId", playlistID)
+			http.Error(w, "invalid playlist id", http.StatusBadRequest)
+			return
+		}
+
+		if !isCacheFresh(cachePath, pls.UpdatedAt) {
+			if err := renderResizedPlaylistImage(pls.ImagePath, cachePath, size); err != nil {
+				log.Error(ctx, "Error rendering resized playlist image", "playlistId", playlistID, "size", size, err)
+				http.Error(w, "internal server error", http.StatusInternalServerError)
+				return
 			}
 		}
 
-		// Clear the image path in the playlist record. Put() bumps UpdatedAt automatically.
-		pls.ImagePath = ""
-		if err := ds.Playlist(ctx).Put(pls); err != nil {
-			log.Error(ctx, "Error clearing playlist image path", "playlistId", playlistID, err)
+		f, err := os.Open(cachePath)
+		if err != nil {
+			log.Error(ctx, "Error opening cached playlist image", "path", cachePath, err)
 			http.Error(w, "internal server error", http.StatusInternalServerError)
 			return
 		}
+		defer f.Close()
 
-		log.Info(ctx, "Custom playlist image removed", "playlistId", playlistID)
+		w.Header().Set("ETag", fmt.Sprintf(`"%s-%d-%d"`, pls.ID, pls.UpdatedAt.UnixNano(), size))
+		// The URL is per playlist and size, not per image, so clients may keep showing a
+		// replaced cover from their cache until this max-age (14 days) runs out.
+		w.Header().Set("Cache-Control", "public, max-age=1209600")
+		http.ServeContent(w, r, "cover.jpg", pls.UpdatedAt, f)
+	}
+}
+
+// playlistArtSource abstracts reading an album's existing cover art so suggested playlist
+// covers can be composed from it. It's satisfied by Navidrome's core/artwork service; the
+// router wires the concrete implementation in when constructing these handlers.
+type playlistArtSource interface {
+	ReadAlbumCover(ctx context.Context, albumID string) (image.Image, error)
+}
+
+const (
+	mosaicCandidateAlbumCount  = 4
+	dominantAlbumShareToSingle = 0.5
+)
+
+// coverCandidateKind identifies how to render a suggested cover candidate.
+type coverCandidateKind string
+
+const (
+	candidateKindSingleAlbum coverCandidateKind = "single-album"
+	candidateKindMosaic      coverCandidateKind = "mosaic"
+)
+
+// coverCandidate is one entry returned by POST .../image/suggest.
+type coverCandidate struct {
+	Token string `json:"token"`
+	Kind  string `json:"kind"`
+}
+
+// coverCandidateToken carries everything needed to regenerate a suggested candidate's image
+// later, so the server doesn't need to keep suggestion state around between the suggest and
+// select calls.
+type coverCandidateToken struct {
+	PlaylistID string             `json:"p"`
+	Kind       coverCandidateKind `json:"k"`
+	AlbumIDs   []string           `json:"a"`
+}
+
+func encodeCoverCandidateToken(t coverCandidateToken) (string, error) {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeCoverCandidateToken(raw string) (coverCandidateToken, error) {
+	var t coverCandidateToken
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return t, fmt.Errorf("invalid token: %w", err)
+	}
+	if err := json.Unmarshal(data, &t); err != nil {
+		return t, fmt.Errorf("invalid token: %w", err)
+	}
+	return t, nil
+}
+
+// rankPlaylistAlbums returns the playlist's album IDs ordered by how many tracks of the
+// playlist belong to each album, most represented first.
+func rankPlaylistAlbums(pls *model.Playlist) []string {
+	counts := map[string]int{}
+	var order []string
+	for _, t := range pls.Tracks {
+		id := t.MediaFile.AlbumID
+		if id == "" {
+			continue
+		}
+		if _, seen := counts[id]; !seen {
+			order = append(order, id)
+		}
+		counts[id]++
+	}
+	sort.SliceStable(order, func(i, j int) bool { return counts[order[i]] > counts[order[j]] })
+	return order
+}
+
+// albumIDsSubsetOf reports whether every id in ids is present in allowed, so a token crafted
+// from a playlist's past track listing can't be replayed to pull in an album the playlist never
+// actually contained.
+func albumIDsSubsetOf(ids, allowed []string) bool {
+	allowedSet := make(map[string]bool, len(allowed))
+	for _, id := range allowed {
+		allowedSet[id] = true
+	}
+	for _, id := range ids {
+		if !allowedSet[id] {
+			return false
+		}
+	}
+	return true
+}
+
+// renderSingleAlbumCover renders a candidate cover from a single album's existing art.
+func renderSingleAlbumCover(ctx context.Context, art playlistArtSource, albumID string) (image.Image, error) {
+	img, err := art.ReadAlbumCover(ctx, albumID)
+	if err != nil {
+		return nil, fmt.Errorf("reading album cover: %w", err)
+	}
+	return imaging.Fill(img, maxImageDimension, maxImageDimension, imaging.Center, imaging.Lanczos), nil
+}
+
+// renderMosaicCover renders a 2x2 mosaic from up to four albums' existing art.
+func renderMosaicCover(ctx context.Context, art playlistArtSource, albumIDs []string) (image.Image, error) {
+	if len(albumIDs) == 0 {
+		return nil, errors.New("no albums to build a mosaic from")
+	}
+	const tile = maxImageDimension / 2
+	mosaic := imaging.New(tile*2, tile*2, color.White)
+	positions := [4]image.Point{{X: 0, Y: 0}, {X: tile, Y: 0}, {X: 0, Y: tile}, {X: tile, Y: tile}}
+	for i, pos := range positions {
+		albumID := albumIDs[i%len(albumIDs)]
+		img, err := art.ReadAlbumCover(ctx, albumID)
+		if err != nil {
+			return nil, fmt.Errorf("reading album cover: %w", err)
+		}
+		square := imaging.Fill(img, tile, tile, imaging.Center, imaging.Lanczos)
+		mosaic = imaging.Paste(mosaic, square, pos)
+	}
+	return mosaic, nil
+}
+
+// renderCoverCandidate regenerates the image for a candidate from its token, so the suggest
+// and select steps always agree on what a given token produces.
+func renderCoverCandidate(ctx context.Context, art playlistArtSource, t coverCandidateToken) (image.Image, error) {
+	switch t.Kind {
+	case candidateKindSingleAlbum:
+		if len(t.AlbumIDs) != 1 {
+			return nil, errors.New("single-album candidate must reference exactly one album")
+		}
+		return renderSingleAlbumCover(ctx, art, t.AlbumIDs[0])
+	case candidateKindMosaic:
+		return renderMosaicCover(ctx, art, t.AlbumIDs)
+	default:
+		return nil, fmt.Errorf("unknown candidate kind %q", t.Kind)
+	}
+}
+
+// suggestPlaylistCoverCandidates returns candidate covers generated from the playlist's own
+// track metadata (most-represented albums), without saving anything. Each candidate carries an
+// opaque token that a follow-up call to .../image/select uses to promote it to the real cover.
+func suggestPlaylistCoverCandidates(ds model.DataStore, art playlistArtSource) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		playlistID := chi.URLParam(r, "playlistId")
+
+		pls, status, err := getPlaylistForImageUpdate(ctx, ds, playlistID)
+		if err != nil {
+			if status == http.StatusInternalServerError {
+				log.Error(ctx, "Error fetching playlist", "playlistId", playlistID, err)
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+
+		full, err := ds.Playlist(ctx).GetWithTracks(playlistID, false, false)
+		if err != nil {
+			log.Error(ctx, "Error fetching playlist tracks", "playlistId", playlistID, err)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			return
+		}
+
+		ranked := rankPlaylistAlbums(full)
+		if len(ranked) == 0 {
+			http.Error(w, "playlist has no tracks to suggest a cover from", http.StatusUnprocessableEntity)
+			return
+		}
+
+		var candidates []coverCandidate
+
+		counts := map[string]int{}
+		for _, t := range full.Tracks {
+			counts[t.MediaFile.AlbumID]++
+		}
+		if share := float64(counts[ranked[0]]) / float64(len(full.Tracks)); share >= dominantAlbumShareToSingle {
+			token, err := encodeCoverCandidateToken(coverCandidateToken{PlaylistID: pls.ID, Kind: candidateKindSingleAlbum, AlbumIDs: ranked[:1]})
+			if err != nil {
+				log.Error(ctx, "Error encoding cover candidate token", err)
+				http.Error(w, "internal server error", http.StatusInternalServerError)
+				return
+			}
+			candidates = append(candidates, coverCandidate{Token: token, Kind: string(candidateKindSingleAlbum)})
+		}
+
+		mosaicAlbums := ranked
+		if len(mosaicAlbums) > mosaicCandidateAlbumCount {
+			mosaicAlbums = mosaicAlbums[:mosaicCandidateAlbumCount]
+		}
+		if len(mosaicAlbums) >= 2 {
+			token, err := encodeCoverCandidateToken(coverCandidateToken{PlaylistID: pls.ID, Kind: candidateKindMosaic, AlbumIDs: mosaicAlbums})
+			if err != nil {
+				log.Error(ctx, "Error encoding cover candidate token", err)
+				http.Error(w, "internal server error", http.StatusInternalServerError)
+				return
+			}
+			candidates = append(candidates, coverCandidate{Token: token, Kind: string(candidateKindMosaic)})
+		}
+
+		// Candidates from external agents (Last.fm/Spotify, searched by playlist name) are a
+		// natural next source here, following the same agents.Agents lookup used for artist
+		// images elsewhere in Navidrome, but require that framework to be wired into this
+		// handler's constructor; left as a follow-up once that dependency is available here.
 
 		resp, err := json.Marshal(struct {
-			ID string `json:"id"`
-		}{ID: playlistID})
+			Candidates []coverCandidate `json:"candidates"`
+		}{Candidates: candidates})
 		if err != nil {
 			log.Error(ctx, "Error marshalling response", err)
 			http.Error(w, "internal server error", http.StatusInternalServerError)
@@ -210,3 +873,70 @@ func deletePlaylistImage(ds model.DataStore) http.HandlerFunc {
 		_, _ = w.Write(resp)
 	}
 }
+
+// selectPlaylistCoverRequest is the JSON body accepted by selectPlaylistCoverCandidate.
+type selectPlaylistCoverRequest struct {
+	Token string `json:"token"`
+}
+
+// selectPlaylistCoverCandidate promotes a candidate previously returned by
+// suggestPlaylistCoverCandidates into the playlist's real cover image.
+func selectPlaylistCoverCandidate(ds model.DataStore, art playlistArtSource) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		playlistID := chi.URLParam(r, "playlistId")
+
+		pls, status, err := getPlaylistForImageUpdate(ctx, ds, playlistID)
+		if err != nil {
+			if status == http.StatusInternalServerError {
+				log.Error(ctx, "Error fetching playlist", "playlistId", playlistID, err)
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+
+		var req selectPlaylistCoverRequest
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 4<<10)).Decode(&req); err != nil || req.Token == "" {
+			http.Error(w, `missing or invalid "token" field`, http.StatusBadRequest)
+			return
+		}
+
+		token, err := decodeCoverCandidateToken(req.Token)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if token.PlaylistID != playlistID {
+			http.Error(w, "token does not belong to this playlist", http.StatusBadRequest)
+			return
+		}
+
+		full, err := ds.Playlist(ctx).GetWithTracks(playlistID, false, false)
+		if err != nil {
+			log.Error(ctx, "Error fetching playlist tracks", "playlistId", playlistID, err)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			return
+		}
+		if !albumIDsSubsetOf(token.AlbumIDs, rankPlaylistAlbums(full)) {
+			http.Error(w, "token references albums that are not part of this playlist", http.StatusBadRequest)
+			return
+		}
+
+		img, err := renderCoverCandidate(ctx, art, token)
+		if err != nil {
+			log.Error(ctx, "Error rendering selected cover candidate", "playlistId", playlistID, err)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			return
+		}
+
+		if err := processAndStorePlaylistImage(ctx, ds, pls, img); err != nil {
+			log.Error(ctx, "Error storing selected playlist cover", "playlistId", playlistID, err)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			return
+		}
+
+		log.Info(ctx, "Playlist cover selected from suggestion", "playlistId", playlistID, "kind", token.Kind)
+
+		writeImageResponse(ctx, w, playlistID, pls.ImageBlurHash)
+	}
+}
@@ -0,0 +1,13 @@
+package nativeapi
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPlaylistImage(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Playlist Image Suite")
+}
@@ -0,0 +1,87 @@
+package nativeapi
+
+import (
+	"net"
+
+	"github.com/navidrome/navidrome/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("isBlockedFetchAddr", func() {
+	DescribeTable("blocked addresses",
+		func(ip string) {
+			Expect(isBlockedFetchAddr(net.ParseIP(ip))).To(BeTrue())
+		},
+		Entry("loopback v4", "127.0.0.1"),
+		Entry("loopback v6", "::1"),
+		Entry("unspecified v4", "0.0.0.0"),
+		Entry("link-local unicast", "169.254.169.254"),
+		Entry("link-local multicast", "224.0.0.1"),
+		Entry("private 10/8", "10.0.0.5"),
+		Entry("private 172.16/12", "172.16.0.5"),
+		Entry("private 192.168/16", "192.168.1.5"),
+	)
+
+	DescribeTable("allowed addresses",
+		func(ip string) {
+			Expect(isBlockedFetchAddr(net.ParseIP(ip))).To(BeFalse())
+		},
+		Entry("public v4", "8.8.8.8"),
+		Entry("public v6", "2001:4860:4860::8888"),
+	)
+})
+
+var _ = Describe("clampPlaylistImageSize", func() {
+	DescribeTable("rounds up to the smallest allowed size",
+		func(requested, expected int) {
+			Expect(clampPlaylistImageSize(requested)).To(Equal(expected))
+		},
+		Entry("below smallest", 1, 64),
+		Entry("exact match", 256, 256),
+		Entry("between two sizes", 300, 512),
+		Entry("above largest falls back to largest", 10000, 1200),
+	)
+})
+
+var _ = Describe("coverCandidateToken", func() {
+	It("round-trips through encode/decode", func() {
+		t := coverCandidateToken{PlaylistID: "pls-1", Kind: candidateKindMosaic, AlbumIDs: []string{"al-1", "al-2"}}
+		raw, err := encodeCoverCandidateToken(t)
+		Expect(err).ToNot(HaveOccurred())
+
+		decoded, err := decodeCoverCandidateToken(raw)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decoded).To(Equal(t))
+	})
+
+	It("rejects garbage tokens", func() {
+		_, err := decodeCoverCandidateToken("not-valid-base64!!!")
+		Expect(err).To(HaveOccurred())
+
+		_, err = decodeCoverCandidateToken("bm90IGpzb24=")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("rankPlaylistAlbums", func() {
+	It("orders albums by track count, most represented first", func() {
+		pls := &model.Playlist{Tracks: model.PlaylistTracks{
+			{MediaFile: model.MediaFile{AlbumID: "al-1"}},
+			{MediaFile: model.MediaFile{AlbumID: "al-2"}},
+			{MediaFile: model.MediaFile{AlbumID: "al-1"}},
+			{MediaFile: model.MediaFile{AlbumID: ""}},
+		}}
+		Expect(rankPlaylistAlbums(pls)).To(Equal([]string{"al-1", "al-2"}))
+	})
+})
+
+var _ = Describe("albumIDsSubsetOf", func() {
+	It("accepts ids that are all present in allowed", func() {
+		Expect(albumIDsSubsetOf([]string{"al-1"}, []string{"al-1", "al-2"})).To(BeTrue())
+	})
+
+	It("rejects an id that isn't in allowed", func() {
+		Expect(albumIDsSubsetOf([]string{"al-1", "al-3"}, []string{"al-1", "al-2"})).To(BeFalse())
+	})
+})